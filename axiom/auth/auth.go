@@ -0,0 +1,136 @@
+// Package auth implements the OAuth 2.0 flows axiom-go based CLIs use to
+// authenticate users interactively: an authorization code flow secured with
+// PKCE ([Login]) for environments with a browser and a reachable loopback
+// redirect, and the device authorization grant ([LoginDevice]) for headless
+// environments where that redirect is impossible.
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+
+	"github.com/axiomhq/axiom-go/axiom/auth/pkce"
+)
+
+// defaultClientID is the OAuth client ID used by Axiom's first-party CLI
+// tools to authenticate on behalf of the user.
+const defaultClientID = "13c885a8-f46a-4424-82d2-883cf7ccfe49"
+
+// LoginFunc is called with the URL the user must open in a browser to
+// authenticate and give consent. Implementations typically open the URL in
+// the system's default browser.
+type LoginFunc func(ctx context.Context, loginURL string) error
+
+// Login performs the OAuth 2.0 authorization code flow, secured with PKCE,
+// against the Axiom instance at baseURL. It starts a local redirect
+// listener, invokes login with the URL to open, waits for the resulting
+// redirect and exchanges the authorization code for a [Token].
+func Login(ctx context.Context, baseURL string, login LoginFunc) (*Token, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("auth: start redirect listener: %w", err)
+	}
+	defer listener.Close()
+
+	redirectURI := fmt.Sprintf("http://%s/callback", listener.Addr().String())
+
+	verifier, err := pkce.NewVerifier()
+	if err != nil {
+		return nil, fmt.Errorf("auth: generate pkce verifier: %w", err)
+	}
+	challenge := verifier.Challenge(pkce.MethodS256)
+
+	state, err := randomState()
+	if err != nil {
+		return nil, fmt.Errorf("auth: generate state: %w", err)
+	}
+
+	authorizeURL, err := buildAuthorizeURL(baseURL, redirectURI, state, challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	codeCh := make(chan string, 1)
+	errCh := make(chan error, 1)
+
+	srv := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if errMsg := r.FormValue("error"); errMsg != "" {
+				errCh <- fmt.Errorf("auth: authorization failed: %s", errMsg)
+				http.Error(w, errMsg, http.StatusBadRequest)
+				return
+			}
+
+			if got := r.FormValue("state"); got != state {
+				errCh <- fmt.Errorf("auth: state mismatch in redirect")
+				http.Error(w, "state mismatch", http.StatusBadRequest)
+				return
+			}
+
+			codeCh <- r.FormValue("code")
+			fmt.Fprint(w, "You may now close this window and return to the application.")
+		}),
+	}
+	go func() { _ = srv.Serve(listener) }()
+	defer srv.Close()
+
+	if err := login(ctx, authorizeURL); err != nil {
+		return nil, fmt.Errorf("auth: open login url: %w", err)
+	}
+
+	var code string
+	select {
+	case code = <-codeCh:
+	case err := <-errCh:
+		return nil, err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	return requestToken(ctx, baseURL, authorizationCodeForm(code, redirectURI, verifier))
+}
+
+func buildAuthorizeURL(baseURL, redirectURI, state string, challenge pkce.Challenge) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("auth: invalid base url: %w", err)
+	}
+	u.Path = "/oauth/authorize"
+
+	q := u.Query()
+	q.Set("client_id", defaultClientID)
+	q.Set("response_type", "code")
+	q.Set("scope", "*")
+	q.Set("redirect_uri", redirectURI)
+	q.Set("state", state)
+	q.Set("code_challenge", challenge.String())
+	q.Set("code_challenge_method", string(pkce.MethodS256))
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+func authorizationCodeForm(code, redirectURI string, verifier pkce.Verifier) url.Values {
+	return url.Values{
+		"grant_type":    {"authorization_code"},
+		"client_id":     {defaultClientID},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"code_verifier": {verifier.String()},
+	}
+}
+
+// randomState generates a cryptographically random, URL-safe state value
+// used to bind an authorization request to its redirect.
+func randomState() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
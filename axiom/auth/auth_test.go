@@ -88,5 +88,5 @@ func TestLogin(t *testing.T) {
 	token, err := auth.Login(context.Background(), srv.URL, loginFunc)
 	require.NoError(t, err)
 
-	assert.Equal(t, "test-token", token)
+	assert.Equal(t, "test-token", token.AccessToken)
 }
\ No newline at end of file
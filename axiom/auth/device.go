@@ -0,0 +1,218 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// defaultDeviceInterval is the polling interval used when the server
+// doesn't report one, as permitted by RFC 8628 section 3.2.
+const defaultDeviceInterval = 5 * time.Second
+
+// DeviceCode holds the response of a device authorization request, as
+// defined by RFC 8628 section 3.2.
+type DeviceCode struct {
+	// DeviceCode is the code the device polls the token endpoint with. It
+	// is never shown to the user.
+	DeviceCode string
+	// UserCode is the code the user enters at VerificationURI.
+	UserCode string
+	// VerificationURI is the URL the user opens to enter UserCode.
+	VerificationURI string
+	// VerificationURIComplete is VerificationURI with UserCode already
+	// filled in, for clients able to render it as a single link or QR code.
+	VerificationURIComplete string
+	// ExpiresIn is how long DeviceCode and UserCode remain valid.
+	ExpiresIn time.Duration
+	// Interval is the minimum time to wait between polls of the token
+	// endpoint.
+	Interval time.Duration
+}
+
+// DisplayFunc is called once the device and user codes have been obtained,
+// so a CLI can print the user code and verification URI for the user to
+// open on a second device.
+type DisplayFunc func(ctx context.Context, code DeviceCode) error
+
+// LoginDevice performs the OAuth 2.0 Device Authorization Grant (RFC 8628)
+// against the Axiom instance at baseURL. It is the flow to use in headless
+// environments, like CI runners, SSH sessions or containers, where
+// [Login]'s loopback redirect is unreachable.
+func LoginDevice(ctx context.Context, baseURL string, display DisplayFunc) (*Token, error) {
+	code, err := requestDeviceCode(ctx, baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := display(ctx, code); err != nil {
+		return nil, fmt.Errorf("auth: display device code: %w", err)
+	}
+
+	return pollDeviceToken(ctx, baseURL, code)
+}
+
+// deviceAuthorizationResponse is the JSON body returned by the
+// /oauth/device_authorization endpoint.
+type deviceAuthorizationResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+func requestDeviceCode(ctx context.Context, baseURL string) (DeviceCode, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return DeviceCode{}, fmt.Errorf("auth: invalid base url: %w", err)
+	}
+	u.Path = "/oauth/device_authorization"
+
+	form := url.Values{
+		"client_id": {defaultClientID},
+		"scope":     {"*"},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return DeviceCode{}, fmt.Errorf("auth: build device authorization request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return DeviceCode{}, fmt.Errorf("auth: request device code: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return DeviceCode{}, fmt.Errorf("auth: device authorization endpoint returned status %d", resp.StatusCode)
+	}
+
+	var dr deviceAuthorizationResponse
+	if err := json.NewDecoder(resp.Body).Decode(&dr); err != nil {
+		return DeviceCode{}, fmt.Errorf("auth: decode device authorization response: %w", err)
+	}
+
+	interval := time.Duration(dr.Interval) * time.Second
+	if interval <= 0 {
+		interval = defaultDeviceInterval
+	}
+
+	return DeviceCode{
+		DeviceCode:              dr.DeviceCode,
+		UserCode:                dr.UserCode,
+		VerificationURI:         dr.VerificationURI,
+		VerificationURIComplete: dr.VerificationURIComplete,
+		ExpiresIn:               time.Duration(dr.ExpiresIn) * time.Second,
+		Interval:                interval,
+	}, nil
+}
+
+// deviceErrorResponse is the error body returned while polling the token
+// endpoint, as defined by RFC 8628 section 3.5.
+type deviceErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// pollDeviceToken polls the token endpoint for the device code until it is
+// authorized, denied, or expires, honoring the errors defined by RFC 8628
+// section 3.5.
+func pollDeviceToken(ctx context.Context, baseURL string, code DeviceCode) (*Token, error) {
+	interval := code.Interval
+	deadline := time.Now().Add(code.ExpiresIn)
+
+	form := url.Values{
+		"grant_type":  {"urn:ietf:params:oauth:grant-type:device_code"},
+		"device_code": {code.DeviceCode},
+		"client_id":   {defaultClientID},
+	}
+
+	for {
+		if code.ExpiresIn > 0 && time.Now().After(deadline) {
+			return nil, fmt.Errorf("auth: device code expired before authorization completed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		token, deviceErr, err := requestDeviceToken(ctx, baseURL, form)
+		if err != nil {
+			return nil, err
+		}
+		if deviceErr == "" {
+			return token, nil
+		}
+
+		switch deviceErr {
+		case "authorization_pending":
+			// Keep polling at the current interval.
+		case "slow_down":
+			interval += 5 * time.Second
+		case "access_denied":
+			return nil, fmt.Errorf("auth: user denied the authorization request")
+		case "expired_token":
+			return nil, fmt.Errorf("auth: device code expired before authorization completed")
+		default:
+			return nil, fmt.Errorf("auth: device token endpoint returned error %q", deviceErr)
+		}
+	}
+}
+
+// requestDeviceToken polls the token endpoint once. If the server responds
+// with an RFC 8628 error body, it is returned as deviceErr rather than err
+// so the caller can decide how to react to it.
+func requestDeviceToken(ctx context.Context, baseURL string, form url.Values) (token *Token, deviceErr string, err error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, "", fmt.Errorf("auth: invalid base url: %w", err)
+	}
+	u.Path = "/oauth/token"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, "", fmt.Errorf("auth: build device token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("auth: request device token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var er deviceErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&er); err == nil && er.Error != "" {
+			return nil, er.Error, nil
+		}
+		return nil, "", fmt.Errorf("auth: device token endpoint returned status %d", resp.StatusCode)
+	}
+
+	var tr tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tr); err != nil {
+		return nil, "", fmt.Errorf("auth: decode device token response: %w", err)
+	}
+
+	result := &Token{
+		AccessToken:  tr.AccessToken,
+		RefreshToken: tr.RefreshToken,
+		TokenType:    tr.TokenType,
+	}
+	if tr.ExpiresIn > 0 {
+		result.ExpiresAt = time.Now().Add(time.Duration(tr.ExpiresIn) * time.Second)
+	}
+
+	return result, "", nil
+}
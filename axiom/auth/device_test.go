@@ -0,0 +1,94 @@
+package auth_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axiomhq/axiom-go/axiom/auth"
+)
+
+func TestLoginDevice(t *testing.T) {
+	var polls int
+
+	r := http.NewServeMux()
+	r.HandleFunc("/oauth/device_authorization", func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "13c885a8-f46a-4424-82d2-883cf7ccfe49", req.FormValue("client_id"))
+		assert.Equal(t, "*", req.FormValue("scope"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"device_code":               "test-device-code",
+			"user_code":                 "ABCD-EFGH",
+			"verification_uri":          "https://example.com/device",
+			"verification_uri_complete": "https://example.com/device?user_code=ABCD-EFGH",
+			"expires_in":                600,
+			"interval":                  1,
+		})
+	})
+	r.HandleFunc("/oauth/token", func(w http.ResponseWriter, req *http.Request) {
+		polls++
+
+		assert.Equal(t, "urn:ietf:params:oauth:grant-type:device_code", req.FormValue("grant_type"))
+		assert.Equal(t, "test-device-code", req.FormValue("device_code"))
+
+		w.Header().Set("Content-Type", "application/json")
+		if polls < 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]any{"error": "authorization_pending"})
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "test-device-token",
+			"token_type":   "bearer",
+		})
+	})
+
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	var displayed auth.DeviceCode
+	display := func(_ context.Context, code auth.DeviceCode) error {
+		displayed = code
+		return nil
+	}
+
+	token, err := auth.LoginDevice(context.Background(), srv.URL, display)
+	require.NoError(t, err)
+
+	assert.Equal(t, "test-device-token", token.AccessToken)
+	assert.Equal(t, "ABCD-EFGH", displayed.UserCode)
+	assert.Equal(t, 2, polls)
+}
+
+func TestLoginDevice_AccessDenied(t *testing.T) {
+	r := http.NewServeMux()
+	r.HandleFunc("/oauth/device_authorization", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"device_code": "test-device-code",
+			"user_code":   "ABCD-EFGH",
+			"expires_in":  600,
+			"interval":    1,
+		})
+	})
+	r.HandleFunc("/oauth/token", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]any{"error": "access_denied"})
+	})
+
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	_, err := auth.LoginDevice(context.Background(), srv.URL, func(context.Context, auth.DeviceCode) error {
+		return nil
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// FileCache persists [Token]s to disk so CLIs built on axiom-go don't have
+// to re-prompt the user on every invocation. Tokens are stored in a single
+// JSON file, keyed by base URL and client ID, under the user's XDG state
+// directory with 0600 permissions.
+type FileCache struct {
+	path string
+}
+
+// NewFileCache returns a [FileCache] backed by a file at the XDG-compliant
+// state path for appName, e.g. "axiom-cli".
+func NewFileCache(appName string) (*FileCache, error) {
+	dir, err := xdgStateDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileCache{path: filepath.Join(dir, appName, "tokens.json")}, nil
+}
+
+type cacheKey struct {
+	BaseURL  string `json:"baseUrl"`
+	ClientID string `json:"clientId"`
+}
+
+type cacheEntry struct {
+	Key   cacheKey `json:"key"`
+	Token Token    `json:"token"`
+}
+
+type cacheFile struct {
+	Entries []cacheEntry `json:"entries"`
+}
+
+// Load returns the cached [Token] for (baseURL, clientID), or nil if none
+// is cached.
+func (c *FileCache) Load(baseURL, clientID string) (*Token, error) {
+	file, err := c.readFile()
+	if err != nil {
+		return nil, err
+	}
+
+	key := cacheKey{BaseURL: baseURL, ClientID: clientID}
+	for _, entry := range file.Entries {
+		if entry.Key == key {
+			token := entry.Token
+			return &token, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Store persists token for (baseURL, clientID), replacing any token
+// previously cached for the same key.
+func (c *FileCache) Store(baseURL, clientID string, token *Token) error {
+	file, err := c.readFile()
+	if err != nil {
+		return err
+	}
+
+	key := cacheKey{BaseURL: baseURL, ClientID: clientID}
+
+	var replaced bool
+	for i, entry := range file.Entries {
+		if entry.Key == key {
+			file.Entries[i].Token = *token
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		file.Entries = append(file.Entries, cacheEntry{Key: key, Token: *token})
+	}
+
+	return c.writeFile(file)
+}
+
+func (c *FileCache) readFile() (cacheFile, error) {
+	b, err := os.ReadFile(c.path)
+	if os.IsNotExist(err) {
+		return cacheFile{}, nil
+	} else if err != nil {
+		return cacheFile{}, fmt.Errorf("auth: read token cache: %w", err)
+	}
+
+	var file cacheFile
+	if err := json.Unmarshal(b, &file); err != nil {
+		return cacheFile{}, fmt.Errorf("auth: decode token cache: %w", err)
+	}
+
+	return file, nil
+}
+
+func (c *FileCache) writeFile(file cacheFile) error {
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o700); err != nil {
+		return fmt.Errorf("auth: create token cache directory: %w", err)
+	}
+
+	b, err := json.Marshal(file)
+	if err != nil {
+		return fmt.Errorf("auth: encode token cache: %w", err)
+	}
+
+	if err := os.WriteFile(c.path, b, 0o600); err != nil {
+		return fmt.Errorf("auth: write token cache: %w", err)
+	}
+
+	return nil
+}
+
+// xdgStateDir returns the user's XDG state directory, honoring
+// $XDG_STATE_HOME and falling back to the XDG-specified default of
+// ~/.local/state.
+func xdgStateDir() (string, error) {
+	if dir := os.Getenv("XDG_STATE_HOME"); dir != "" {
+		return dir, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("auth: determine home directory: %w", err)
+	}
+
+	return filepath.Join(home, ".local", "state"), nil
+}
@@ -0,0 +1,55 @@
+package auth_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axiomhq/axiom-go/axiom/auth"
+)
+
+func TestFileCache_StoreAndLoad(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	cache, err := auth.NewFileCache("axiom-test")
+	require.NoError(t, err)
+
+	token := &auth.Token{AccessToken: "test-token", RefreshToken: "test-refresh-token"}
+	require.NoError(t, cache.Store("https://example.com", "client-id", token))
+
+	got, err := cache.Load("https://example.com", "client-id")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, *token, *got)
+
+	_, err = cache.Load("https://example.com", "other-client-id")
+	require.NoError(t, err)
+}
+
+func TestFileCache_Load_Missing(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	cache, err := auth.NewFileCache("axiom-test")
+	require.NoError(t, err)
+
+	got, err := cache.Load("https://example.com", "client-id")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestFileCache_Store_FilePermissions(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_STATE_HOME", dir)
+
+	cache, err := auth.NewFileCache("axiom-test")
+	require.NoError(t, err)
+
+	require.NoError(t, cache.Store("https://example.com", "client-id", &auth.Token{AccessToken: "test-token"}))
+
+	info, err := os.Stat(filepath.Join(dir, "axiom-test", "tokens.json"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
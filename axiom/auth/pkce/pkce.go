@@ -0,0 +1,77 @@
+// Package pkce implements RFC 7636 Proof Key for Code Exchange, used to
+// secure the OAuth 2.0 authorization code flow for public clients, such as
+// the Axiom CLI, that cannot keep a client secret confidential.
+package pkce
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// Method is a PKCE code challenge method.
+type Method string
+
+// MethodS256 transforms the verifier with SHA-256 before comparing it to
+// the challenge, as required by RFC 7636 for public clients.
+const MethodS256 Method = "S256"
+
+// Verifier is a PKCE code verifier: a high-entropy random string sent to
+// the token endpoint to prove possession of the value that produced the
+// code challenge sent to the authorization endpoint.
+type Verifier string
+
+// NewVerifier generates a new cryptographically random [Verifier].
+func NewVerifier() (Verifier, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return Verifier(encode(b)), nil
+}
+
+// VerifierFromString wraps an already encoded verifier value, e.g. one
+// received over the wire, as a [Verifier].
+func VerifierFromString(s string) Verifier {
+	return Verifier(s)
+}
+
+// String returns the verifier's encoded string representation.
+func (v Verifier) String() string {
+	return string(v)
+}
+
+// Challenge derives the code challenge to send to the authorization
+// endpoint by transforming v with method.
+func (v Verifier) Challenge(method Method) Challenge {
+	if method == MethodS256 {
+		sum := sha256.Sum256([]byte(v))
+		return Challenge(encode(sum[:]))
+	}
+	return Challenge(v)
+}
+
+// Challenge is a PKCE code challenge, derived from a [Verifier] and sent to
+// the authorization endpoint.
+type Challenge string
+
+// ChallengeFromString wraps an already encoded challenge value, e.g. one
+// received over the wire, as a [Challenge].
+func ChallengeFromString(s string) Challenge {
+	return Challenge(s)
+}
+
+// String returns the challenge's encoded string representation.
+func (c Challenge) String() string {
+	return string(c)
+}
+
+// Verify reports whether verifier, transformed with method, produces c.
+func (c Challenge) Verify(verifier Verifier, method Method) bool {
+	return c == verifier.Challenge(method)
+}
+
+// encode base64url-encodes b without padding, as required by RFC 7636.
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,38 @@
+package pkce_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axiomhq/axiom-go/axiom/auth/pkce"
+)
+
+func TestVerifier_Challenge(t *testing.T) {
+	verifier, err := pkce.NewVerifier()
+	require.NoError(t, err)
+
+	challenge := verifier.Challenge(pkce.MethodS256)
+	assert.True(t, challenge.Verify(verifier, pkce.MethodS256))
+}
+
+func TestChallenge_Verify_RejectsWrongVerifier(t *testing.T) {
+	verifier, err := pkce.NewVerifier()
+	require.NoError(t, err)
+	other, err := pkce.NewVerifier()
+	require.NoError(t, err)
+
+	challenge := verifier.Challenge(pkce.MethodS256)
+	assert.False(t, challenge.Verify(other, pkce.MethodS256))
+}
+
+func TestChallengeFromString_RoundTrip(t *testing.T) {
+	verifier, err := pkce.NewVerifier()
+	require.NoError(t, err)
+
+	challenge := verifier.Challenge(pkce.MethodS256)
+	roundTripped := pkce.ChallengeFromString(challenge.String())
+
+	assert.True(t, roundTripped.Verify(verifier, pkce.MethodS256))
+}
@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Token holds an OAuth 2.0 access token obtained via [Login] or
+// [LoginDevice], along with what is needed to refresh it before it expires.
+type Token struct {
+	// AccessToken authenticates requests against the Axiom API.
+	AccessToken string
+	// RefreshToken, if any, can be exchanged for a new [Token] once
+	// AccessToken expires, without involving the user again.
+	RefreshToken string
+	// TokenType is the token type reported by the server, usually
+	// "bearer".
+	TokenType string
+	// ExpiresAt is when AccessToken expires. Zero if the server didn't
+	// report an expiry.
+	ExpiresAt time.Time
+}
+
+// Valid reports whether t holds a usable, non-expired access token.
+func (t *Token) Valid() bool {
+	return t != nil && t.AccessToken != "" && (t.ExpiresAt.IsZero() || time.Now().Before(t.ExpiresAt))
+}
+
+// tokenResponse is the JSON body returned by the /oauth/token endpoint on a
+// successful grant.
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+// requestToken POSTs form to the /oauth/token endpoint of the Axiom
+// instance at baseURL and returns the resulting [Token].
+func requestToken(ctx context.Context, baseURL string, form url.Values) (*Token, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("auth: invalid base url: %w", err)
+	}
+	u.Path = "/oauth/token"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("auth: build token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("auth: request token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("auth: token endpoint returned status %d: %s", resp.StatusCode, body)
+	}
+
+	var tr tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tr); err != nil {
+		return nil, fmt.Errorf("auth: decode token response: %w", err)
+	}
+
+	token := &Token{
+		AccessToken:  tr.AccessToken,
+		RefreshToken: tr.RefreshToken,
+		TokenType:    tr.TokenType,
+	}
+	if tr.ExpiresIn > 0 {
+		token.ExpiresAt = time.Now().Add(time.Duration(tr.ExpiresIn) * time.Second)
+	}
+
+	return token, nil
+}
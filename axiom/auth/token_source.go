@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// tokenExpiryMargin is how long before its reported expiry a token is
+// refreshed, to avoid racing the server's own clock.
+const tokenExpiryMargin = 30 * time.Second
+
+// TokenSource serves a [Token], transparently refreshing it against
+// baseURL's /oauth/token endpoint once it is about to expire, so
+// long-running processes don't silently start failing requests with 401
+// once the initial token lapses.
+type TokenSource struct {
+	baseURL string
+
+	mu    sync.Mutex
+	token *Token
+}
+
+// NewTokenSource returns a [TokenSource] that serves token for baseURL,
+// refreshing it with its refresh token as needed.
+func NewTokenSource(baseURL string, token *Token) *TokenSource {
+	return &TokenSource{baseURL: baseURL, token: token}
+}
+
+// Token returns a valid access token, refreshing it first if it is about to
+// expire and a refresh token is available.
+func (s *TokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.Valid() && (s.token.ExpiresAt.IsZero() || time.Until(s.token.ExpiresAt) > tokenExpiryMargin) {
+		return s.token, nil
+	}
+
+	if s.token == nil || s.token.RefreshToken == "" {
+		return nil, fmt.Errorf("auth: access token expired and no refresh token is available")
+	}
+
+	refreshed, err := requestToken(ctx, s.baseURL, url.Values{
+		"grant_type":    {"refresh_token"},
+		"client_id":     {defaultClientID},
+		"refresh_token": {s.token.RefreshToken},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("auth: refresh token: %w", err)
+	}
+
+	// Servers may omit the refresh token on a refresh response, meaning it
+	// didn't change. Keep using the one we already have in that case.
+	if refreshed.RefreshToken == "" {
+		refreshed.RefreshToken = s.token.RefreshToken
+	}
+
+	s.token = refreshed
+
+	return s.token, nil
+}
@@ -0,0 +1,60 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axiomhq/axiom-go/axiom/auth"
+)
+
+func TestTokenSource_Token_RefreshesExpiredToken(t *testing.T) {
+	var refreshes int
+
+	r := http.NewServeMux()
+	r.HandleFunc("/oauth/token", func(w http.ResponseWriter, req *http.Request) {
+		refreshes++
+
+		assert.Equal(t, "refresh_token", req.FormValue("grant_type"))
+		assert.Equal(t, "test-refresh-token", req.FormValue("refresh_token"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token": "refreshed-token", "token_type": "bearer"}`))
+	})
+
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	ts := auth.NewTokenSource(srv.URL, &auth.Token{
+		AccessToken:  "expired-token",
+		RefreshToken: "test-refresh-token",
+		ExpiresAt:    time.Now().Add(-time.Minute),
+	})
+
+	token, err := ts.Token(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "refreshed-token", token.AccessToken)
+	assert.Equal(t, 1, refreshes)
+
+	// A second call with the still-valid refreshed token shouldn't refresh
+	// again.
+	_, err = ts.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, refreshes)
+}
+
+func TestTokenSource_Token_NoRefreshTokenAvailable(t *testing.T) {
+	ts := auth.NewTokenSource("https://example.com", &auth.Token{
+		AccessToken: "expired-token",
+		ExpiresAt:   time.Now().Add(-time.Minute),
+	})
+
+	_, err := ts.Token(context.Background())
+	assert.Error(t, err)
+}
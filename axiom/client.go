@@ -0,0 +1,128 @@
+// Package axiom provides the Go client library for the Axiom API.
+package axiom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/axiomhq/axiom-go/axiom/auth"
+	"github.com/axiomhq/axiom-go/axiom/middleware"
+)
+
+const defaultURL = "https://api.axiom.co"
+
+// Client is the client for the Axiom API.
+type Client struct {
+	baseURL    *url.URL
+	httpClient *http.Client
+
+	accessToken string
+	tokenSource *auth.TokenSource
+	orgID       string
+	userAgent   string
+}
+
+// authorizationHeader returns the "Bearer <token>" value to send on a
+// request, refreshing the token source first if the client was configured
+// with one via [SetTokenSource].
+func (c *Client) authorizationHeader(ctx context.Context) (string, error) {
+	if c.tokenSource != nil {
+		token, err := c.tokenSource.Token(ctx)
+		if err != nil {
+			return "", fmt.Errorf("axiom: refresh access token: %w", err)
+		}
+		return "Bearer " + token.AccessToken, nil
+	}
+
+	return "Bearer " + c.accessToken, nil
+}
+
+// Option is a function that configures a [Client]. It is applied in the
+// order given to [NewClient].
+type Option func(client *Client) error
+
+// NewClient returns a new [Client] configured with the given options. It
+// returns an error if a required option, like the access token, is missing
+// or invalid.
+func NewClient(options ...Option) (*Client, error) {
+	baseURL, err := url.Parse(defaultURL)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &Client{
+		baseURL:    baseURL,
+		httpClient: &http.Client{},
+		userAgent:  "axiom-go",
+	}
+
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		if err := option(client); err != nil {
+			return nil, err
+		}
+	}
+
+	if client.accessToken == "" && client.tokenSource == nil {
+		return nil, fmt.Errorf("axiom: access token must be set")
+	}
+
+	return client, nil
+}
+
+// Use appends middlewares to the client's request pipeline. Middlewares
+// run in the order given, with the first middleware seeing the request
+// first and the response last.
+func (c *Client) Use(middlewares ...middleware.Middleware) {
+	base := c.httpClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	c.httpClient.Transport = middleware.Chain(base, middlewares...)
+}
+
+// SetAccessToken sets the access token used to authenticate against the
+// Axiom API.
+func SetAccessToken(accessToken string) Option {
+	return func(client *Client) error {
+		client.accessToken = accessToken
+		return nil
+	}
+}
+
+// SetTokenSource configures the client to authenticate using ts instead of
+// a static access token, so long-running processes keep working past the
+// expiry of the token ts was created with.
+func SetTokenSource(ts *auth.TokenSource) Option {
+	return func(client *Client) error {
+		client.tokenSource = ts
+		return nil
+	}
+}
+
+// SetOrgID sets the organization ID requests are scoped to. Only required
+// when the access token is a personal token that can access more than one
+// organization.
+func SetOrgID(orgID string) Option {
+	return func(client *Client) error {
+		client.orgID = orgID
+		return nil
+	}
+}
+
+// SetURL sets the base URL used to talk to the Axiom API. Defaults to
+// Axiom Cloud.
+func SetURL(baseURL string) Option {
+	return func(client *Client) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return fmt.Errorf("axiom: invalid base url: %w", err)
+		}
+		client.baseURL = u
+		return nil
+	}
+}
@@ -0,0 +1,67 @@
+package axiom_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axiomhq/axiom-go/axiom"
+	"github.com/axiomhq/axiom-go/axiom/auth"
+	"github.com/axiomhq/axiom-go/axiom/middleware"
+	"github.com/axiomhq/axiom-go/axiom/query"
+)
+
+func TestNewClient(t *testing.T) {
+	client, err := axiom.NewClient(axiom.SetAccessToken("test-token"))
+	require.NoError(t, err)
+	assert.NotNil(t, client)
+}
+
+func TestNewClient_MissingAccessToken(t *testing.T) {
+	_, err := axiom.NewClient()
+	assert.Error(t, err)
+}
+
+func TestNewClient_InvalidURL(t *testing.T) {
+	_, err := axiom.NewClient(
+		axiom.SetAccessToken("test-token"),
+		axiom.SetURL(":not-a-url"),
+	)
+	assert.Error(t, err)
+}
+
+func TestNewClient_TokenSourceSatisfiesAccessTokenRequirement(t *testing.T) {
+	ts := auth.NewTokenSource("https://example.com", &auth.Token{AccessToken: "test-token"})
+
+	client, err := axiom.NewClient(axiom.SetTokenSource(ts))
+	require.NoError(t, err)
+	assert.NotNil(t, client)
+}
+
+func TestClient_Use(t *testing.T) {
+	var gotHeader string
+
+	r := http.NewServeMux()
+	r.HandleFunc("/v1/datasets/_apl", func(w http.ResponseWriter, req *http.Request) {
+		gotHeader = req.Header.Get(middleware.RequestIDHeader)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	client, err := axiom.NewClient(axiom.SetAccessToken("test-token"), axiom.SetURL(srv.URL))
+	require.NoError(t, err)
+
+	client.Use(middleware.RequestID())
+
+	_, err = client.Query(context.Background(), "['test']", query.Options{})
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, gotHeader)
+}
@@ -0,0 +1,29 @@
+// Package middleware provides composable [http.RoundTripper] wrappers for
+// the axiom [Client], so cross-cutting request concerns like retries,
+// tracing and rate-limiting can be added to the request pipeline without
+// touching request code.
+package middleware
+
+import "net/http"
+
+// Middleware wraps an [http.RoundTripper] with additional behavior.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// Chain composes middlewares into a single [http.RoundTripper] wrapping
+// base, applying them in the order given: the first middleware is the
+// outermost wrapper and sees the request first.
+func Chain(base http.RoundTripper, middlewares ...Middleware) http.RoundTripper {
+	rt := base
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		rt = middlewares[i](rt)
+	}
+	return rt
+}
+
+// RoundTripperFunc adapts a function to an [http.RoundTripper].
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip implements [http.RoundTripper].
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited is returned by the [RateLimit] middleware when it blocks a
+// request locally because the previous response reported no remaining
+// quota and the reset time hasn't passed yet.
+var ErrRateLimited = errors.New("middleware: rate limit exceeded")
+
+// rateLimitState holds the rate limit last reported by Axiom's
+// X-RateLimit-* response headers.
+type rateLimitState struct {
+	remaining int
+	reset     time.Time
+	known     bool
+}
+
+// RateLimit returns a [Middleware] that parses Axiom's
+// X-RateLimit-Remaining and X-RateLimit-Reset response headers and fails
+// fast with [ErrRateLimited] instead of issuing a request known to be
+// rejected, until the reported reset time has passed.
+func RateLimit() Middleware {
+	var (
+		mu    sync.Mutex
+		state rateLimitState
+	)
+
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			mu.Lock()
+			blocked := state.known && state.remaining <= 0 && time.Now().Before(state.reset)
+			mu.Unlock()
+
+			if blocked {
+				return nil, ErrRateLimited
+			}
+
+			resp, err := next.RoundTrip(req)
+			if err != nil {
+				return resp, err
+			}
+
+			if next := parseRateLimit(resp.Header); next.known {
+				mu.Lock()
+				state = next
+				mu.Unlock()
+			}
+
+			return resp, nil
+		})
+	}
+}
+
+func parseRateLimit(h http.Header) rateLimitState {
+	remaining, err := strconv.Atoi(h.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return rateLimitState{}
+	}
+
+	var reset time.Time
+	if resetUnix, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		reset = time.Unix(resetUnix, 0)
+	}
+
+	return rateLimitState{remaining: remaining, reset: reset, known: true}
+}
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+)
+
+// RequestIDHeader is the header [RequestID] sets on every outgoing
+// request.
+const RequestIDHeader = "X-Request-Id"
+
+// RequestID returns a [Middleware] that sets RequestIDHeader to a new
+// random ID on every outgoing request that doesn't already carry one, so
+// requests can be correlated across client logs and server-side traces.
+func RequestID() Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get(RequestIDHeader) == "" {
+				id, err := newRequestID()
+				if err != nil {
+					return nil, err
+				}
+				req = req.Clone(req.Context())
+				req.Header.Set(RequestIDHeader, id)
+			}
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+func newRequestID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,59 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axiomhq/axiom-go/axiom/middleware"
+)
+
+func TestRequestID(t *testing.T) {
+	var gotHeader string
+
+	r := http.NewServeMux()
+	r.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		gotHeader = req.Header.Get(middleware.RequestIDHeader)
+	})
+
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	client := &http.Client{Transport: middleware.Chain(http.DefaultTransport, middleware.RequestID())}
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.NotEmpty(t, gotHeader)
+}
+
+func TestRequestID_DoesNotOverrideExisting(t *testing.T) {
+	var gotHeader string
+
+	r := http.NewServeMux()
+	r.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		gotHeader = req.Header.Get(middleware.RequestIDHeader)
+	})
+
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	client := &http.Client{Transport: middleware.Chain(http.DefaultTransport, middleware.RequestID())}
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+	req.Header.Set(middleware.RequestIDHeader, "custom-id")
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, "custom-id", gotHeader)
+}
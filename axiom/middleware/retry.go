@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultMaxRetries = 3
+	defaultBaseDelay  = 500 * time.Millisecond
+)
+
+// RetryOptions configures the [Retry] middleware.
+type RetryOptions struct {
+	// MaxRetries is the maximum number of retries before giving up.
+	// Defaults to 3 if zero.
+	MaxRetries int
+	// BaseDelay is the delay before the first retry. Subsequent retries
+	// double it unless the server specifies a Retry-After. Defaults to
+	// 500ms if zero.
+	BaseDelay time.Duration
+}
+
+// Retry returns a [Middleware] that retries requests that fail with a
+// retryable status code (429 or 5xx) using exponential backoff with
+// jitter, honoring a server-provided Retry-After header when present.
+func Retry(opts RetryOptions) Middleware {
+	if opts.MaxRetries <= 0 {
+		opts.MaxRetries = defaultMaxRetries
+	}
+	if opts.BaseDelay <= 0 {
+		opts.BaseDelay = defaultBaseDelay
+	}
+
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			var resp *http.Response
+			var err error
+
+			for attempt := 0; attempt <= opts.MaxRetries; attempt++ {
+				if attempt > 0 && req.Body != nil {
+					body, bodyErr := req.GetBody()
+					if bodyErr != nil {
+						return nil, bodyErr
+					}
+					req.Body = body
+				}
+
+				resp, err = next.RoundTrip(req)
+				if err != nil || !isRetryable(resp) || attempt == opts.MaxRetries {
+					return resp, err
+				}
+
+				// A request body that can't be rewound (no GetBody) can only
+				// be sent once; give up retrying and return this response
+				// as-is rather than consuming it and retrying with an empty
+				// body.
+				if req.Body != nil && req.GetBody == nil {
+					return resp, err
+				}
+
+				delay := retryDelay(resp, opts.BaseDelay, attempt)
+				resp.Body.Close()
+
+				select {
+				case <-req.Context().Done():
+					return nil, req.Context().Err()
+				case <-time.After(delay):
+				}
+			}
+
+			return resp, err
+		})
+	}
+}
+
+func isRetryable(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+func retryDelay(resp *http.Response, base time.Duration, attempt int) time.Duration {
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+
+	backoff := float64(base) * math.Pow(2, float64(attempt))
+	jitter := rand.Float64() * float64(base)
+	return time.Duration(backoff + jitter)
+}
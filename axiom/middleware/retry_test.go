@@ -0,0 +1,69 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axiomhq/axiom-go/axiom/middleware"
+)
+
+func TestRetry(t *testing.T) {
+	var requests int
+
+	r := http.NewServeMux()
+	r.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		if requests < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	mw := middleware.Retry(middleware.RetryOptions{MaxRetries: 3, BaseDelay: time.Millisecond})
+	client := &http.Client{Transport: middleware.Chain(http.DefaultTransport, mw)}
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 3, requests)
+}
+
+func TestRetry_GivesUpAfterMaxRetries(t *testing.T) {
+	var requests int
+
+	r := http.NewServeMux()
+	r.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	mw := middleware.Retry(middleware.RetryOptions{MaxRetries: 2, BaseDelay: time.Millisecond})
+	client := &http.Client{Transport: middleware.Chain(http.DefaultTransport, mw)}
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, 3, requests) // initial attempt + 2 retries
+}
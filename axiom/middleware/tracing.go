@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing returns a [Middleware] that starts a span for every request using
+// the OpenTelemetry tracer registered under tracerName, and records a
+// query result's rowsExamined/rowsMatched counters as span attributes when
+// the response body carries them.
+func Tracing(tracerName string) Middleware {
+	tracer := otel.Tracer(tracerName)
+
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			ctx, span := tracer.Start(req.Context(), req.Method+" "+req.URL.Path)
+			defer span.End()
+
+			resp, err := next.RoundTrip(req.WithContext(ctx))
+			if err != nil {
+				span.RecordError(err)
+				return resp, err
+			}
+
+			span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
+
+			if resp.Body != nil {
+				body, readErr := io.ReadAll(resp.Body)
+				resp.Body.Close()
+				if readErr == nil {
+					resp.Body = io.NopCloser(bytes.NewReader(body))
+					recordQueryStatus(span, body)
+				}
+			}
+
+			return resp, nil
+		})
+	}
+}
+
+// recordQueryStatus records the rowsExamined/rowsMatched counters of a
+// [query.Result]'s status as span attributes, if body is a JSON object with
+// a "status" member. It is decoded structurally here, rather than via
+// [query.Result], to avoid this package depending on the query package for
+// two integer fields.
+func recordQueryStatus(span trace.Span, body []byte) {
+	var result struct {
+		Status *struct {
+			RowsExamined uint64 `json:"rowsExamined"`
+			RowsMatched  uint64 `json:"rowsMatched"`
+		} `json:"status"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil || result.Status == nil {
+		return
+	}
+
+	span.SetAttributes(
+		attribute.Int64("axiom.rows_examined", int64(result.Status.RowsExamined)),
+		attribute.Int64("axiom.rows_matched", int64(result.Status.RowsMatched)),
+	)
+}
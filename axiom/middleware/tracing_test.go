@@ -0,0 +1,37 @@
+package middleware_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axiomhq/axiom-go/axiom/middleware"
+)
+
+func TestTracing_PreservesResponseBody(t *testing.T) {
+	r := http.NewServeMux()
+	r.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":{"rowsExamined":10,"rowsMatched":2}}`))
+	})
+
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	client := &http.Client{Transport: middleware.Chain(http.DefaultTransport, middleware.Tracing("axiom-go-test"))}
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"status":{"rowsExamined":10,"rowsMatched":2}}`, string(body))
+}
@@ -0,0 +1,75 @@
+package axiom
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/axiomhq/axiom-go/axiom/query"
+)
+
+const queryPath = "/v1/datasets/_apl"
+
+// queryRequest is the body sent to the APL query endpoint.
+type queryRequest struct {
+	APL           string `json:"apl"`
+	Cursor        string `json:"cursor,omitempty"`
+	IncludeCursor bool   `json:"includeCursor,omitempty"`
+	Limit         int    `json:"limit,omitempty"`
+}
+
+// Query executes apl against Axiom and returns the [query.Result].
+func (c *Client) Query(ctx context.Context, apl string, opts query.Options) (*query.Result, error) {
+	body, err := json.Marshal(queryRequest{
+		APL:           apl,
+		Cursor:        opts.Cursor,
+		IncludeCursor: opts.IncludeCursor,
+		Limit:         opts.Limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("axiom: encode query request: %w", err)
+	}
+
+	endpoint := c.baseURL.ResolveReference(&url.URL{Path: queryPath})
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("axiom: build query request: %w", err)
+	}
+	authHeader, err := c.authorizationHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", authHeader)
+	if c.orgID != "" {
+		req.Header.Set("X-Axiom-Org-Id", c.orgID)
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("axiom: execute query request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("axiom: query failed with status %d: %s", resp.StatusCode, msg)
+	}
+
+	var result query.Result
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("axiom: decode query result: %w", err)
+	}
+	result.TraceID = resp.Header.Get("X-Axiom-Trace-Id")
+
+	return &result, nil
+}
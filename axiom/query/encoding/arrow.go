@@ -0,0 +1,217 @@
+package encoding
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/arrow/ipc"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+
+	"github.com/axiomhq/axiom-go/axiom/query"
+)
+
+// ArrowEncoder encodes a [query.Result] as a single Apache Arrow IPC stream,
+// one record batch per table, so results can be consumed directly by tools
+// like DuckDB, pandas, or Polars without an intermediate text format. An IPC
+// stream carries one schema for all its record batches, so every table in
+// result must share the same fields; a result with differently-shaped
+// tables is rejected rather than silently truncated.
+type ArrowEncoder struct{}
+
+// Encode implements [Encoder].
+func (ArrowEncoder) Encode(w io.Writer, result *query.Result) error {
+	pool := memory.NewGoAllocator()
+
+	var (
+		writer       *ipc.Writer
+		writerSchema *arrow.Schema
+	)
+	defer func() {
+		if writer != nil {
+			writer.Close()
+		}
+	}()
+
+	for _, table := range result.Tables {
+		fields := make([]arrow.Field, len(table.Fields))
+		builders := make([]array.Builder, len(table.Fields))
+		for i, f := range table.Fields {
+			dt := arrowType(f.Type)
+			fields[i] = arrow.Field{Name: f.Name, Type: dt, Nullable: true}
+			builders[i] = array.NewBuilder(pool, dt)
+		}
+		schema := arrow.NewSchema(fields, nil)
+
+		var rowCount int
+		if len(table.Columns) > 0 {
+			rowCount = len(table.Columns[0])
+		}
+
+		for i, col := range table.Columns {
+			if i >= len(builders) {
+				break
+			}
+			for row := 0; row < rowCount; row++ {
+				if err := appendValue(builders[i], col[row]); err != nil {
+					releaseBuilders(builders)
+					return fmt.Errorf("encoding: arrow column %q: %w", table.Fields[i].Name, err)
+				}
+			}
+		}
+
+		arrays := make([]arrow.Array, len(builders))
+		for i, b := range builders {
+			arrays[i] = b.NewArray()
+			b.Release()
+		}
+		record := array.NewRecord(schema, arrays, int64(rowCount))
+
+		if writer == nil {
+			writer = ipc.NewWriter(w, ipc.WithSchema(schema))
+			writerSchema = schema
+		} else if !schema.Equal(writerSchema) {
+			record.Release()
+			for _, a := range arrays {
+				a.Release()
+			}
+			return fmt.Errorf("encoding: arrow: table %q's schema differs from the stream's first table; an IPC stream can only carry one schema", table.Name)
+		}
+
+		err := writer.Write(record)
+
+		record.Release()
+		for _, a := range arrays {
+			a.Release()
+		}
+
+		if err != nil {
+			return fmt.Errorf("encoding: write arrow record: %w", err)
+		}
+	}
+
+	if writer != nil {
+		if err := writer.Close(); err != nil {
+			return fmt.Errorf("encoding: close arrow writer: %w", err)
+		}
+		writer = nil
+	}
+
+	return nil
+}
+
+// releaseBuilders releases builders that were never converted into arrays,
+// e.g. because appendValue failed partway through a table.
+func releaseBuilders(builders []array.Builder) {
+	for _, b := range builders {
+		b.Release()
+	}
+}
+
+// arrowType maps an Axiom [query.Field.Type] to an Arrow data type.
+// Composite union types, e.g. "string|int", fall back to their first listed
+// type, since Arrow has no direct equivalent of a dynamically-typed column.
+func arrowType(fieldType string) arrow.DataType {
+	if primary, _, ok := strings.Cut(fieldType, "|"); ok {
+		fieldType = primary
+	}
+
+	switch fieldType {
+	case "int":
+		return arrow.PrimitiveTypes.Int64
+	case "float":
+		return arrow.PrimitiveTypes.Float64
+	case "bool":
+		return arrow.FixedWidthTypes.Boolean
+	case "datetime":
+		return arrow.FixedWidthTypes.Timestamp_ns
+	default:
+		return arrow.BinaryTypes.String
+	}
+}
+
+// appendValue appends value to builder, coercing it as needed, or appends
+// null if value is nil.
+func appendValue(builder array.Builder, value any) error {
+	if value == nil {
+		builder.AppendNull()
+		return nil
+	}
+
+	switch b := builder.(type) {
+	case *array.Int64Builder:
+		v, ok := toInt64(value)
+		if !ok {
+			return fmt.Errorf("cannot convert %T to int64", value)
+		}
+		b.Append(v)
+	case *array.Float64Builder:
+		v, ok := toFloat64(value)
+		if !ok {
+			return fmt.Errorf("cannot convert %T to float64", value)
+		}
+		b.Append(v)
+	case *array.BooleanBuilder:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("cannot convert %T to bool", value)
+		}
+		b.Append(v)
+	case *array.TimestampBuilder:
+		t, ok := toTime(value)
+		if !ok {
+			return fmt.Errorf("cannot convert %T to a timestamp", value)
+		}
+		b.Append(arrow.Timestamp(t.UnixNano()))
+	case *array.StringBuilder:
+		b.Append(fmt.Sprint(value))
+	default:
+		return fmt.Errorf("unsupported arrow builder %T", builder)
+	}
+
+	return nil
+}
+
+func toInt64(value any) (int64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return v, true
+	case int:
+		return int64(v), true
+	case float64:
+		return int64(v), true
+	default:
+		return 0, false
+	}
+}
+
+func toFloat64(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+func toTime(value any) (time.Time, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, true
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return t, true
+	default:
+		return time.Time{}, false
+	}
+}
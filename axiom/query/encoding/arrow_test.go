@@ -0,0 +1,90 @@
+package encoding_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/apache/arrow/go/v17/arrow/ipc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axiomhq/axiom-go/axiom/query"
+	"github.com/axiomhq/axiom-go/axiom/query/encoding"
+)
+
+func TestArrowEncoder_Encode(t *testing.T) {
+	result := &query.Result{
+		Tables: []query.Table{{
+			Fields: []query.Field{
+				{Name: "_time", Type: "datetime"},
+				{Name: "count", Type: "int"},
+			},
+			Columns: []query.Column{
+				{"2023-01-01T00:00:00Z"},
+				{int64(42)},
+			},
+		}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, encoding.ArrowEncoder{}.Encode(&buf, result))
+
+	reader, err := ipc.NewReader(&buf)
+	require.NoError(t, err)
+	defer reader.Release()
+
+	require.True(t, reader.Next())
+	record := reader.Record()
+
+	assert.Equal(t, int64(1), record.NumRows())
+	assert.Equal(t, "_time", record.ColumnName(0))
+	assert.Equal(t, "count", record.ColumnName(1))
+}
+
+func TestArrowEncoder_Encode_MultipleTablesSameSchema(t *testing.T) {
+	table := query.Table{
+		Fields: []query.Field{
+			{Name: "_time", Type: "datetime"},
+			{Name: "count", Type: "int"},
+		},
+		Columns: []query.Column{
+			{"2023-01-01T00:00:00Z"},
+			{int64(42)},
+		},
+	}
+	result := &query.Result{Tables: []query.Table{table, table}}
+
+	var buf bytes.Buffer
+	require.NoError(t, encoding.ArrowEncoder{}.Encode(&buf, result))
+
+	reader, err := ipc.NewReader(&buf)
+	require.NoError(t, err)
+	defer reader.Release()
+
+	var batches int
+	for reader.Next() {
+		batches++
+		assert.Equal(t, int64(1), reader.Record().NumRows())
+	}
+	assert.Equal(t, 2, batches, "both tables must be readable from a single IPC stream")
+}
+
+func TestArrowEncoder_Encode_MismatchedSchemasRejected(t *testing.T) {
+	result := &query.Result{
+		Tables: []query.Table{
+			{
+				Name:    "a",
+				Fields:  []query.Field{{Name: "count", Type: "int"}},
+				Columns: []query.Column{{int64(1)}},
+			},
+			{
+				Name:    "b",
+				Fields:  []query.Field{{Name: "name", Type: "string"}},
+				Columns: []query.Column{{"foo"}},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	assert.Error(t, encoding.ArrowEncoder{}.Encode(&buf, result))
+}
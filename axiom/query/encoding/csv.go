@@ -0,0 +1,117 @@
+package encoding
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/axiomhq/axiom-go/axiom/query"
+)
+
+// CSVEncoder encodes a [query.Result] as CSV, one header row followed by
+// the data rows of each table in turn. Group fields are emitted first,
+// followed by the fields named in [query.Table.Order]. It implements
+// [RowEncoder], so [Client.QueryAndEncode] can write it incrementally.
+type CSVEncoder struct{}
+
+// Encode implements [Encoder].
+func (e CSVEncoder) Encode(w io.Writer, result *query.Result) error {
+	cw := csv.NewWriter(w)
+
+	for _, table := range result.Tables {
+		if err := e.writeHeader(cw, table); err != nil {
+			return err
+		}
+		for row := range table.Rows() {
+			if err := e.writeRow(cw, table, row); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// EncodeHeader implements [RowEncoder].
+func (e CSVEncoder) EncodeHeader(w io.Writer, table query.Table) error {
+	cw := csv.NewWriter(w)
+	if err := e.writeHeader(cw, table); err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// EncodeRow implements [RowEncoder].
+func (e CSVEncoder) EncodeRow(w io.Writer, table query.Table, row query.Row) error {
+	cw := csv.NewWriter(w)
+	if err := e.writeRow(cw, table, row); err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func (CSVEncoder) writeHeader(cw *csv.Writer, table query.Table) error {
+	columns := orderedColumns(table)
+
+	header := make([]string, len(columns))
+	for i, c := range columns {
+		header[i] = table.Fields[c].Name
+	}
+	if err := cw.Write(header); err != nil {
+		return fmt.Errorf("encoding: write csv header: %w", err)
+	}
+
+	return nil
+}
+
+func (CSVEncoder) writeRow(cw *csv.Writer, table query.Table, row query.Row) error {
+	columns := orderedColumns(table)
+
+	record := make([]string, len(columns))
+	for i, c := range columns {
+		v, _ := row.Get(table.Fields[c].Name)
+		record[i] = fmt.Sprint(v)
+	}
+	if err := cw.Write(record); err != nil {
+		return fmt.Errorf("encoding: write csv row: %w", err)
+	}
+
+	return nil
+}
+
+// orderedColumns returns the field indices of table in the order they
+// should be encoded: group fields first, then the fields named in
+// [query.Table.Order], then any remaining fields in their original order.
+func orderedColumns(table query.Table) []int {
+	seen := make(map[int]bool, len(table.Fields))
+
+	var columns []int
+	appendField := func(name string) {
+		for i, f := range table.Fields {
+			if f.Name == name && !seen[i] {
+				seen[i] = true
+				columns = append(columns, i)
+				return
+			}
+		}
+	}
+
+	for _, g := range table.Groups {
+		appendField(g.Name)
+	}
+	for _, o := range table.Order {
+		appendField(o.Field)
+	}
+	for i := range table.Fields {
+		if !seen[i] {
+			seen[i] = true
+			columns = append(columns, i)
+		}
+	}
+
+	return columns
+}
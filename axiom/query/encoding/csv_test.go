@@ -0,0 +1,34 @@
+package encoding_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axiomhq/axiom-go/axiom/query"
+	"github.com/axiomhq/axiom-go/axiom/query/encoding"
+)
+
+func TestCSVEncoder_Encode(t *testing.T) {
+	result := &query.Result{
+		Tables: []query.Table{{
+			Groups: []query.Group{{Name: "service"}},
+			Fields: []query.Field{
+				{Name: "count", Type: "int"},
+				{Name: "service", Type: "string"},
+			},
+			Order: []query.Order{{Field: "count", Desc: true}},
+			Columns: []query.Column{
+				{int64(1)},
+				{"api"},
+			},
+		}},
+	}
+
+	var buf strings.Builder
+	require.NoError(t, encoding.CSVEncoder{}.Encode(&buf, result))
+
+	assert.Equal(t, "service,count\napi,1\n", buf.String())
+}
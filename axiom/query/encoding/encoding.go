@@ -0,0 +1,34 @@
+// Package encoding provides [Encoder] implementations that serialize a
+// [query.Result] to an [io.Writer] in formats consumable by downstream
+// tooling such as spreadsheets, log pipelines, or columnar data engines.
+package encoding
+
+import (
+	"io"
+
+	"github.com/axiomhq/axiom-go/axiom/query"
+)
+
+// Encoder writes a [query.Result] to w in a specific wire format.
+type Encoder interface {
+	Encode(w io.Writer, result *query.Result) error
+}
+
+// RowEncoder is implemented by [Encoder]s whose wire format can be written
+// incrementally, one table and row at a time, as pages of a result arrive
+// from the server. [Client.QueryAndEncode] prefers it when the passed
+// [Encoder] implements it, bounding memory use to one page of rows instead
+// of the full result. Formats that need a complete batch of values before
+// they can flush anything, such as Arrow's columnar record batches, don't
+// implement it and are used through [Encoder.Encode] instead.
+type RowEncoder interface {
+	Encoder
+
+	// EncodeHeader writes whatever header table's format requires (e.g.
+	// CSV column names). It is called once, before table's first
+	// EncodeRow call.
+	EncodeHeader(w io.Writer, table query.Table) error
+	// EncodeRow writes a single row of table, as yielded by
+	// [query.Table.Rows].
+	EncodeRow(w io.Writer, table query.Table, row query.Row) error
+}
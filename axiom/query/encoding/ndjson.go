@@ -0,0 +1,45 @@
+package encoding
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/axiomhq/axiom-go/axiom/query"
+)
+
+// NDJSONEncoder encodes a [query.Result] as newline-delimited JSON, writing
+// one JSON object per row, across all tables of the result in turn. It
+// implements [RowEncoder], so [Client.QueryAndEncode] can write it
+// incrementally.
+type NDJSONEncoder struct{}
+
+// Encode implements [Encoder].
+func (e NDJSONEncoder) Encode(w io.Writer, result *query.Result) error {
+	for _, table := range result.Tables {
+		for row := range table.Rows() {
+			if err := e.EncodeRow(w, table, row); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// EncodeHeader implements [RowEncoder]. NDJSON has no header.
+func (NDJSONEncoder) EncodeHeader(io.Writer, query.Table) error {
+	return nil
+}
+
+// EncodeRow implements [RowEncoder].
+func (NDJSONEncoder) EncodeRow(w io.Writer, table query.Table, row query.Row) error {
+	obj := make(map[string]any, len(table.Fields))
+	for _, f := range table.Fields {
+		obj[f.Name], _ = row.Get(f.Name)
+	}
+	if err := json.NewEncoder(w).Encode(obj); err != nil {
+		return fmt.Errorf("encoding: write ndjson row: %w", err)
+	}
+	return nil
+}
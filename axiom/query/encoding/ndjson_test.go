@@ -0,0 +1,35 @@
+package encoding_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axiomhq/axiom-go/axiom/query"
+	"github.com/axiomhq/axiom-go/axiom/query/encoding"
+)
+
+func TestNDJSONEncoder_Encode(t *testing.T) {
+	result := &query.Result{
+		Tables: []query.Table{{
+			Fields: []query.Field{
+				{Name: "name", Type: "string"},
+				{Name: "count", Type: "int"},
+			},
+			Columns: []query.Column{
+				{"foo", "bar"},
+				{float64(1), float64(2)},
+			},
+		}},
+	}
+
+	var buf strings.Builder
+	require.NoError(t, encoding.NDJSONEncoder{}.Encode(&buf, result))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.JSONEq(t, `{"name":"foo","count":1}`, lines[0])
+	assert.JSONEq(t, `{"name":"bar","count":2}`, lines[1])
+}
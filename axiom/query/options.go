@@ -0,0 +1,17 @@
+package query
+
+// Options specifies the parameters for an APL query request.
+type Options struct {
+	// Cursor resumes a time-sorted, non-aggregating query from the given
+	// row id, as obtained from a previous result's [Status.MaxCursor] or
+	// [Status.MinCursor].
+	Cursor string
+	// IncludeCursor specifies whether the row identified by Cursor is
+	// itself included in the result. Leave it true for an initial,
+	// non-resuming request; set it false when resuming from a previous
+	// result's cursor so that row isn't yielded a second time.
+	IncludeCursor bool
+	// Limit caps the number of rows returned by the query. Zero means no
+	// limit is applied.
+	Limit int
+}
@@ -0,0 +1,160 @@
+package query
+
+import (
+	"iter"
+	"reflect"
+	"time"
+
+	"github.com/axiomhq/axiom-go/axiom/query/rowmapper"
+)
+
+// Row is a single row of a [Table], providing access to its values by field
+// name instead of having to index into parallel [Table.Fields] and
+// [Table.Columns] slices.
+type Row struct {
+	table *Table
+	index int
+}
+
+// Get returns the value of the field with the given name in the row and
+// true if the field exists. Otherwise, it returns nil and false.
+func (r Row) Get(fieldName string) (any, bool) {
+	i := r.table.fieldIndex(fieldName)
+	if i < 0 {
+		return nil, false
+	}
+	return r.table.Columns[i][r.index], true
+}
+
+// Time returns the value of the "_time" field as a [time.Time] and true if
+// the field exists and holds a valid time. Otherwise, it returns the zero
+// [time.Time] and false.
+func (r Row) Time() (time.Time, bool) {
+	v, ok := r.Get("_time")
+	if !ok {
+		return time.Time{}, false
+	}
+
+	switch t := v.(type) {
+	case time.Time:
+		return t, true
+	case string:
+		parsed, err := time.Parse(time.RFC3339Nano, t)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return parsed, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// Scan copies the row's fields into dst, which must be a non-nil pointer to
+// a struct. Fields are matched against the struct's "axiom" tag, falling
+// back to its "json" tag and then the field name itself. See the
+// [rowmapper] package for the exact matching and type coercion rules.
+func (r Row) Scan(dst any) error {
+	fields := make([]rowmapper.Field, len(r.table.Fields))
+	values := make([]any, len(r.table.Fields))
+	for i, f := range r.table.Fields {
+		fields[i] = rowmapper.Field{Name: f.Name, Type: f.Type}
+		values[i] = r.table.Columns[i][r.index]
+	}
+	return rowmapper.Scan(fields, values, dst)
+}
+
+// Rows returns an iterator over the rows of the table, in the order the
+// values appear in [Table.Columns].
+func (t *Table) Rows() iter.Seq[Row] {
+	return func(yield func(Row) bool) {
+		for i := 0; i < t.rowCount(); i++ {
+			if !yield(Row{table: t, index: i}) {
+				return
+			}
+		}
+	}
+}
+
+// Group returns a new [Table] containing only the rows whose group-by
+// values, given in the order of [Table.Groups], equal values. It panics if
+// the number of values does not match the number of groups. Group makes it
+// possible to walk sub-grouped results without manually tracking repeated
+// group values across rows.
+func (t *Table) Group(values ...any) *Table {
+	if len(values) != len(t.Groups) {
+		panic("query: number of values must match number of groups")
+	}
+
+	groupFieldIndex := make([]int, len(t.Groups))
+	for i, g := range t.Groups {
+		groupFieldIndex[i] = t.fieldIndex(g.Name)
+	}
+
+	var matches []int
+	for row := 0; row < t.rowCount(); row++ {
+		matched := true
+		for i, fieldIndex := range groupFieldIndex {
+			if fieldIndex < 0 || !valuesEqual(t.Columns[fieldIndex][row], values[i]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			matches = append(matches, row)
+		}
+	}
+
+	columns := make([]Column, len(t.Columns))
+	for i, col := range t.Columns {
+		filtered := make(Column, len(matches))
+		for j, row := range matches {
+			filtered[j] = col[row]
+		}
+		columns[i] = filtered
+	}
+
+	return &Table{
+		Name:    t.Name,
+		Sources: t.Sources,
+		Fields:  t.Fields,
+		Order:   t.Order,
+		Groups:  t.Groups,
+		Range:   t.Range,
+		Buckets: t.Buckets,
+		Columns: columns,
+	}
+}
+
+// valuesEqual reports whether a and b are equal, without panicking if
+// either holds an uncomparable value (e.g. a slice or map decoded from a
+// JSON array or object), which a plain == would.
+func valuesEqual(a, b any) bool {
+	at, bt := reflect.TypeOf(a), reflect.TypeOf(b)
+	if at == nil || bt == nil {
+		return a == nil && b == nil
+	}
+	if at.Comparable() && bt.Comparable() {
+		return a == b
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// fieldIndex returns the index of the field with the given name in
+// [Table.Fields], or -1 if it doesn't exist.
+func (t *Table) fieldIndex(name string) int {
+	for i, f := range t.Fields {
+		if f.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// rowCount returns the number of rows in the table, as derived from the
+// length of its first column.
+func (t *Table) rowCount() int {
+	if len(t.Columns) == 0 {
+		return 0
+	}
+	return len(t.Columns[0])
+}
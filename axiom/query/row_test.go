@@ -0,0 +1,110 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axiomhq/axiom-go/axiom/query"
+)
+
+func TestTable_Rows(t *testing.T) {
+	table := &query.Table{
+		Fields: []query.Field{
+			{Name: "_time", Type: "datetime"},
+			{Name: "count", Type: "int"},
+		},
+		Columns: []query.Column{
+			{"2023-01-01T00:00:00Z", "2023-01-01T00:01:00Z"},
+			{int64(1), int64(2)},
+		},
+	}
+
+	var counts []int64
+	for row := range table.Rows() {
+		v, ok := row.Get("count")
+		require.True(t, ok)
+		counts = append(counts, v.(int64))
+
+		ts, ok := row.Time()
+		require.True(t, ok)
+		assert.Equal(t, 2023, ts.Year())
+	}
+
+	assert.Equal(t, []int64{1, 2}, counts)
+}
+
+func TestRow_Scan(t *testing.T) {
+	table := &query.Table{
+		Fields: []query.Field{
+			{Name: "name", Type: "string"},
+			{Name: "count", Type: "int"},
+		},
+		Columns: []query.Column{
+			{"foo"},
+			{int64(42)},
+		},
+	}
+
+	type dst struct {
+		Name  string `axiom:"name"`
+		Count int    `axiom:"count"`
+	}
+
+	var got dst
+	for row := range table.Rows() {
+		require.NoError(t, row.Scan(&got))
+	}
+
+	assert.Equal(t, dst{Name: "foo", Count: 42}, got)
+}
+
+func TestTable_Group(t *testing.T) {
+	table := &query.Table{
+		Groups: []query.Group{{Name: "service"}},
+		Fields: []query.Field{
+			{Name: "service", Type: "string"},
+			{Name: "count", Type: "int"},
+		},
+		Columns: []query.Column{
+			{"api", "api", "web"},
+			{int64(1), int64(2), int64(3)},
+		},
+	}
+
+	sub := table.Group("api")
+	require.Len(t, sub.Columns, 2)
+	assert.Equal(t, query.Column{"api", "api"}, sub.Columns[0])
+	assert.Equal(t, query.Column{int64(1), int64(2)}, sub.Columns[1])
+}
+
+func TestTable_Group_UncomparableGroupValue(t *testing.T) {
+	table := &query.Table{
+		Groups: []query.Group{{Name: "tags"}},
+		Fields: []query.Field{
+			{Name: "tags", Type: "array"},
+			{Name: "count", Type: "int"},
+		},
+		Columns: []query.Column{
+			{[]any{"a", "b"}, []any{"c"}},
+			{int64(1), int64(2)},
+		},
+	}
+
+	var sub *query.Table
+	assert.NotPanics(t, func() {
+		sub = table.Group([]any{"a", "b"})
+	})
+
+	require.Len(t, sub.Columns[1], 1)
+	assert.Equal(t, int64(1), sub.Columns[1][0])
+}
+
+func TestTable_Group_PanicsOnMismatch(t *testing.T) {
+	table := &query.Table{Groups: []query.Group{{Name: "service"}}}
+
+	assert.Panics(t, func() {
+		table.Group("api", "extra")
+	})
+}
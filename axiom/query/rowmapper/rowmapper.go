@@ -0,0 +1,177 @@
+// Package rowmapper maps the values of a query result row onto a
+// user-defined struct by reflection, matching columns to fields by name.
+// It is kept separate from the query package so it can be reused by
+// encoders and other consumers without importing [query.Table] or
+// [query.Field] directly, avoiding an import cycle.
+package rowmapper
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Field describes a single column to be mapped onto a destination struct.
+// It mirrors the subset of [query.Field] that rowmapper needs.
+type Field struct {
+	// Name of the field.
+	Name string
+	// Type of the field as reported by the server, e.g. "string", "int",
+	// "float", "bool", "datetime" or "duration".
+	Type string
+}
+
+// Scan maps values, matched against fields by name, onto dst. dst must be a
+// non-nil pointer to a struct. Struct fields are matched by their "axiom"
+// tag, falling back to their "json" tag and then the field name itself
+// (case insensitively); a tag of "-" opts the field out of matching
+// entirely, same as [encoding/json]. Values are coerced according to the
+// field's [Field.Type]: "datetime" fields are parsed as [time.RFC3339Nano]
+// when the destination is a [time.Time], "duration" fields are parsed as a
+// [time.ParseDuration] string or treated as nanoseconds when the
+// destination is a [time.Duration], and numeric fields are converted
+// between Go numeric kinds as needed.
+func Scan(fields []Field, values []any, dst any) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("rowmapper: dst must be a non-nil pointer to a struct, got %T", dst)
+	}
+
+	rv = rv.Elem()
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("rowmapper: dst must be a pointer to a struct, got pointer to %s", rv.Kind())
+	}
+
+	structFields := mapStructFields(rv.Type())
+
+	for i, field := range fields {
+		if i >= len(values) {
+			break
+		}
+
+		sf, ok := structFields[strings.ToLower(field.Name)]
+		if !ok {
+			continue
+		}
+
+		if err := setValue(rv.FieldByIndex(sf.Index), field, values[i]); err != nil {
+			return fmt.Errorf("rowmapper: field %q: %w", field.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// mapStructFields indexes the exported fields of t by their resolved column
+// name, lowercased for case-insensitive matching.
+func mapStructFields(t reflect.Type) map[string]reflect.StructField {
+	fields := make(map[string]reflect.StructField, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		name := sf.Name
+		skip := false
+		if tag, ok := sf.Tag.Lookup("axiom"); ok {
+			name, skip = tagName(tag, name)
+		} else if tag, ok := sf.Tag.Lookup("json"); ok {
+			name, skip = tagName(tag, name)
+		}
+		if skip {
+			continue
+		}
+
+		fields[strings.ToLower(name)] = sf
+	}
+	return fields
+}
+
+// tagName returns the name portion of a struct tag value (before the first
+// comma), falling back to fallback if the tag has no name. A bare "-" tag
+// means the field was opted out of matching entirely, reported via skip
+// rather than falling back to fallback.
+func tagName(tag, fallback string) (name string, skip bool) {
+	name, _, _ = strings.Cut(tag, ",")
+	if name == "-" {
+		return "", true
+	}
+	if name == "" {
+		return fallback, false
+	}
+	return name, false
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// setValue assigns value to dst, coercing it according to field's type.
+func setValue(dst reflect.Value, field Field, value any) error {
+	if value == nil {
+		return nil
+	}
+
+	if field.Type == "datetime" && dst.Type() == timeType {
+		switch v := value.(type) {
+		case time.Time:
+			dst.Set(reflect.ValueOf(v))
+		case string:
+			t, err := time.Parse(time.RFC3339Nano, v)
+			if err != nil {
+				return fmt.Errorf("parse datetime: %w", err)
+			}
+			dst.Set(reflect.ValueOf(t))
+		default:
+			return fmt.Errorf("cannot convert %T to time.Time", value)
+		}
+		return nil
+	}
+
+	if field.Type == "duration" && dst.Type() == durationType {
+		switch v := value.(type) {
+		case time.Duration:
+			dst.Set(reflect.ValueOf(v))
+		case string:
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				return fmt.Errorf("parse duration: %w", err)
+			}
+			dst.Set(reflect.ValueOf(d))
+		case float64:
+			dst.Set(reflect.ValueOf(time.Duration(v)))
+		case int64:
+			dst.Set(reflect.ValueOf(time.Duration(v)))
+		default:
+			return fmt.Errorf("cannot convert %T to time.Duration", value)
+		}
+		return nil
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Type() == dst.Type() {
+		dst.Set(rv)
+		return nil
+	}
+
+	if isNumericKind(rv.Kind()) && isNumericKind(dst.Kind()) && rv.Type().ConvertibleTo(dst.Type()) {
+		dst.Set(rv.Convert(dst.Type()))
+		return nil
+	}
+
+	return fmt.Errorf("cannot assign %T to %s", value, dst.Type())
+}
+
+func isNumericKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
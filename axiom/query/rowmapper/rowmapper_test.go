@@ -0,0 +1,75 @@
+package rowmapper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axiomhq/axiom-go/axiom/query/rowmapper"
+)
+
+func TestScan(t *testing.T) {
+	fields := []rowmapper.Field{
+		{Name: "_time", Type: "datetime"},
+		{Name: "name", Type: "string"},
+		{Name: "count", Type: "int"},
+	}
+	values := []any{"2023-01-01T00:00:00Z", "foo", int64(42)}
+
+	type dst struct {
+		Time  time.Time `axiom:"_time"`
+		Name  string    `json:"name"`
+		Count int
+	}
+
+	var d dst
+	require.NoError(t, rowmapper.Scan(fields, values, &d))
+
+	assert.Equal(t, "foo", d.Name)
+	assert.Equal(t, 42, d.Count)
+	assert.Equal(t, 2023, d.Time.Year())
+}
+
+func TestScan_Duration(t *testing.T) {
+	fields := []rowmapper.Field{{Name: "elapsed", Type: "duration"}}
+	values := []any{"1.5s"}
+
+	var d struct {
+		Elapsed time.Duration
+	}
+	require.NoError(t, rowmapper.Scan(fields, values, &d))
+
+	assert.Equal(t, 1500*time.Millisecond, d.Elapsed)
+}
+
+func TestScan_SkipsDashTaggedField(t *testing.T) {
+	fields := []rowmapper.Field{{Name: "name", Type: "string"}}
+	values := []any{"foo"}
+
+	var d struct {
+		Name string `json:"-"`
+	}
+	require.NoError(t, rowmapper.Scan(fields, values, &d))
+
+	assert.Empty(t, d.Name)
+}
+
+func TestScan_UnknownField(t *testing.T) {
+	fields := []rowmapper.Field{{Name: "unknown", Type: "string"}}
+	values := []any{"foo"}
+
+	var d struct{ Name string }
+	require.NoError(t, rowmapper.Scan(fields, values, &d))
+
+	assert.Empty(t, d.Name)
+}
+
+func TestScan_RequiresPointerToStruct(t *testing.T) {
+	var notAPointer struct{ Name string }
+	assert.Error(t, rowmapper.Scan(nil, nil, notAPointer))
+
+	var notAStruct int
+	assert.Error(t, rowmapper.Scan(nil, nil, &notAStruct))
+}
@@ -0,0 +1,84 @@
+package axiom
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/axiomhq/axiom-go/axiom/query"
+	"github.com/axiomhq/axiom-go/axiom/query/encoding"
+)
+
+// queryAndEncodeBatchSize bounds how many rows QueryAndEncode requests per
+// page when enc implements [encoding.RowEncoder].
+const queryAndEncodeBatchSize = 1000
+
+// QueryAndEncode executes apl and writes the result to w using enc. If enc
+// implements [encoding.RowEncoder] (as [encoding.CSVEncoder] and
+// [encoding.NDJSONEncoder] do), the result is paged in from the server and
+// written row by row, bounding memory use to one page of rows rather than
+// the full result. Otherwise — as for [encoding.ArrowEncoder], whose
+// columnar format needs a complete batch of values before it can flush a
+// record — the full [query.Result] is decoded into memory before enc
+// encodes it, the same as calling [Client.Query] followed by
+// [encoding.Encoder.Encode] yourself.
+//
+// Like [Client.QueryStream], the row-by-row path only supports time-sorted,
+// non-aggregating queries, since it resumes pages using the result cursor.
+func (c *Client) QueryAndEncode(ctx context.Context, apl string, enc encoding.Encoder, w io.Writer) error {
+	rowEnc, ok := enc.(encoding.RowEncoder)
+	if !ok {
+		result, err := c.Query(ctx, apl, query.Options{})
+		if err != nil {
+			return fmt.Errorf("axiom: query for encoding: %w", err)
+		}
+
+		if err := enc.Encode(w, result); err != nil {
+			return fmt.Errorf("axiom: encode query result: %w", err)
+		}
+
+		return nil
+	}
+
+	var (
+		cursor        string
+		headerWritten bool
+	)
+	for {
+		result, err := c.Query(ctx, apl, query.Options{
+			Cursor:        cursor,
+			IncludeCursor: cursor == "",
+			Limit:         queryAndEncodeBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("axiom: query for encoding: %w", err)
+		}
+
+		if err := refuseAggregating(result); err != nil {
+			return err
+		}
+
+		var rowCount int
+		for _, table := range result.Tables {
+			if !headerWritten {
+				if err := rowEnc.EncodeHeader(w, table); err != nil {
+					return fmt.Errorf("axiom: encode header: %w", err)
+				}
+				headerWritten = true
+			}
+
+			for row := range table.Rows() {
+				rowCount++
+				if err := rowEnc.EncodeRow(w, table, row); err != nil {
+					return fmt.Errorf("axiom: encode row: %w", err)
+				}
+			}
+		}
+
+		nextCursor := result.Status.MaxCursor
+		if rowCount == 0 || nextCursor == cursor {
+			return nil
+		}
+		cursor = nextCursor
+	}
+}
@@ -0,0 +1,110 @@
+package axiom_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axiomhq/axiom-go/axiom"
+	"github.com/axiomhq/axiom-go/axiom/query"
+	"github.com/axiomhq/axiom-go/axiom/query/encoding"
+)
+
+func TestClient_QueryAndEncode_PagesRowEncoders(t *testing.T) {
+	var calls int
+
+	r := http.NewServeMux()
+	r.HandleFunc("/v1/datasets/_apl", func(w http.ResponseWriter, req *http.Request) {
+		calls++
+
+		var result query.Result
+		switch calls {
+		case 1:
+			result = query.Result{
+				Tables: []query.Table{{
+					Fields:  []query.Field{{Name: "count", Type: "int"}},
+					Columns: []query.Column{{int64(1), int64(2)}},
+				}},
+				Status: query.Status{MaxCursor: "cursor-1"},
+			}
+		case 2:
+			result = query.Result{
+				Tables: []query.Table{{
+					Fields:  []query.Field{{Name: "count", Type: "int"}},
+					Columns: []query.Column{{int64(3)}},
+				}},
+				Status: query.Status{MaxCursor: "cursor-2"},
+			}
+		default:
+			result = query.Result{
+				Tables: []query.Table{{
+					Fields:  []query.Field{{Name: "count", Type: "int"}},
+					Columns: []query.Column{},
+				}},
+				Status: query.Status{MaxCursor: "cursor-2"},
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(result))
+	})
+
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	client, err := axiom.NewClient(axiom.SetAccessToken("test-token"), axiom.SetURL(srv.URL))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, client.QueryAndEncode(context.Background(), "['test']", encoding.NDJSONEncoder{}, &buf))
+
+	require.Equal(t, 3, calls, "must page through results rather than issuing a single query")
+
+	var rows []map[string]any
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var row map[string]any
+		require.NoError(t, dec.Decode(&row))
+		rows = append(rows, row)
+	}
+	require.Len(t, rows, 3)
+	assert.Equal(t, float64(1), rows[0]["count"])
+	assert.Equal(t, float64(2), rows[1]["count"])
+	assert.Equal(t, float64(3), rows[2]["count"])
+}
+
+func TestClient_QueryAndEncode_NonRowEncoderBuffersFullResult(t *testing.T) {
+	var calls int
+
+	r := http.NewServeMux()
+	r.HandleFunc("/v1/datasets/_apl", func(w http.ResponseWriter, req *http.Request) {
+		calls++
+
+		result := query.Result{
+			Tables: []query.Table{{
+				Fields:  []query.Field{{Name: "count", Type: "int"}},
+				Columns: []query.Column{{int64(1)}},
+			}},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(result))
+	})
+
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	client, err := axiom.NewClient(axiom.SetAccessToken("test-token"), axiom.SetURL(srv.URL))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, client.QueryAndEncode(context.Background(), "['test']", encoding.ArrowEncoder{}, &buf))
+
+	assert.Equal(t, 1, calls, "non-RowEncoder path issues a single query")
+	assert.NotEmpty(t, buf.Bytes())
+}
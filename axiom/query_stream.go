@@ -0,0 +1,108 @@
+package axiom
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+
+	"github.com/axiomhq/axiom-go/axiom/query"
+)
+
+// defaultStreamBatchSize is the batch size [Client.QueryStream] uses when
+// [StreamOptions.BatchSize] is left at its zero value.
+const defaultStreamBatchSize = 1000
+
+// StreamOptions configures [Client.QueryStream].
+type StreamOptions struct {
+	// BatchSize is the maximum number of rows requested per underlying
+	// query. Defaults to 1000 if zero.
+	BatchSize int
+	// PollInterval, if set, makes QueryStream keep tailing the dataset:
+	// once a query returns no new rows, it waits PollInterval and issues
+	// the query again instead of stopping.
+	PollInterval time.Duration
+	// Descending resumes the query from [query.Status.MinCursor] instead of
+	// [query.Status.MaxCursor], walking rows backwards in time.
+	Descending bool
+}
+
+// QueryStream repeatedly issues apl, substituting the server-reported
+// cursor into each subsequent request, and yields the resulting rows one by
+// one. It stops once a request returns no new rows, unless
+// [StreamOptions.PollInterval] is set, in which case it keeps polling to
+// "tail" the dataset until ctx is canceled or the iteration is stopped.
+//
+// QueryStream refuses aggregating queries, since cursors are only
+// meaningful for time-sorted, non-aggregating (filtering) queries.
+func (c *Client) QueryStream(ctx context.Context, apl string, opts StreamOptions) iter.Seq2[query.Row, error] {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultStreamBatchSize
+	}
+
+	return func(yield func(query.Row, error) bool) {
+		var cursor string
+		for {
+			result, err := c.Query(ctx, apl, query.Options{
+				Cursor:        cursor,
+				IncludeCursor: cursor == "",
+				Limit:         opts.BatchSize,
+			})
+			if err != nil {
+				yield(query.Row{}, err)
+				return
+			}
+
+			if err := refuseAggregating(result); err != nil {
+				yield(query.Row{}, err)
+				return
+			}
+
+			nextCursor := result.Status.MaxCursor
+			if opts.Descending {
+				nextCursor = result.Status.MinCursor
+			}
+
+			var rowCount int
+			for _, table := range result.Tables {
+				for row := range table.Rows() {
+					rowCount++
+					if !yield(row, nil) {
+						return
+					}
+				}
+			}
+
+			if rowCount == 0 || nextCursor == cursor {
+				if opts.PollInterval <= 0 {
+					return
+				}
+
+				select {
+				case <-ctx.Done():
+					yield(query.Row{}, ctx.Err())
+					return
+				case <-time.After(opts.PollInterval):
+				}
+
+				continue
+			}
+
+			cursor = nextCursor
+		}
+	}
+}
+
+// refuseAggregating returns an error if any field of any table in result
+// carries an aggregation, since cursor-based resumption is only meaningful
+// for filtering queries.
+func refuseAggregating(result *query.Result) error {
+	for _, table := range result.Tables {
+		for _, field := range table.Fields {
+			if field.Aggregation.Name != "" {
+				return fmt.Errorf("axiom: cursor-based paging does not support aggregating queries (field %q aggregates with %q)", field.Name, field.Aggregation.Name)
+			}
+		}
+	}
+	return nil
+}
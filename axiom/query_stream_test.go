@@ -0,0 +1,196 @@
+package axiom_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axiomhq/axiom-go/axiom"
+	"github.com/axiomhq/axiom-go/axiom/query"
+)
+
+func TestClient_QueryStream(t *testing.T) {
+	var calls int
+
+	r := http.NewServeMux()
+	r.HandleFunc("/v1/datasets/_apl", func(w http.ResponseWriter, req *http.Request) {
+		calls++
+
+		var result query.Result
+		if calls == 1 {
+			result = query.Result{
+				Tables: []query.Table{{
+					Fields:  []query.Field{{Name: "_time", Type: "datetime"}, {Name: "count", Type: "int"}},
+					Columns: []query.Column{{"2023-01-01T00:00:00Z"}, {int64(1)}},
+				}},
+				Status: query.Status{MaxCursor: "cursor-1"},
+			}
+		} else {
+			result = query.Result{
+				Tables: []query.Table{{
+					Fields:  []query.Field{{Name: "_time", Type: "datetime"}, {Name: "count", Type: "int"}},
+					Columns: []query.Column{},
+				}},
+				Status: query.Status{MaxCursor: "cursor-1"},
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(result))
+	})
+
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	client, err := axiom.NewClient(axiom.SetAccessToken("test-token"), axiom.SetURL(srv.URL))
+	require.NoError(t, err)
+
+	var rows []query.Row
+	for row, err := range client.QueryStream(context.Background(), "['test']", axiom.StreamOptions{}) {
+		require.NoError(t, err)
+		rows = append(rows, row)
+	}
+
+	assert.Len(t, rows, 1)
+	assert.Equal(t, 2, calls)
+}
+
+func TestClient_QueryStream_NoDuplicateRowsAcrossBatches(t *testing.T) {
+	var calls int
+	var gotIncludeCursor []bool
+
+	r := http.NewServeMux()
+	r.HandleFunc("/v1/datasets/_apl", func(w http.ResponseWriter, req *http.Request) {
+		calls++
+
+		var body struct {
+			IncludeCursor bool `json:"includeCursor"`
+		}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		gotIncludeCursor = append(gotIncludeCursor, body.IncludeCursor)
+
+		var result query.Result
+		switch calls {
+		case 1:
+			result = query.Result{
+				Tables: []query.Table{{
+					Fields:  []query.Field{{Name: "_time", Type: "datetime"}, {Name: "count", Type: "int"}},
+					Columns: []query.Column{{"2023-01-01T00:00:00Z", "2023-01-01T00:00:01Z"}, {int64(1), int64(2)}},
+				}},
+				Status: query.Status{MaxCursor: "cursor-1"},
+			}
+		case 2:
+			result = query.Result{
+				Tables: []query.Table{{
+					Fields:  []query.Field{{Name: "_time", Type: "datetime"}, {Name: "count", Type: "int"}},
+					Columns: []query.Column{{"2023-01-01T00:00:02Z", "2023-01-01T00:00:03Z"}, {int64(3), int64(4)}},
+				}},
+				Status: query.Status{MaxCursor: "cursor-2"},
+			}
+		default:
+			result = query.Result{
+				Tables: []query.Table{{
+					Fields:  []query.Field{{Name: "_time", Type: "datetime"}, {Name: "count", Type: "int"}},
+					Columns: []query.Column{},
+				}},
+				Status: query.Status{MaxCursor: "cursor-2"},
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(result))
+	})
+
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	client, err := axiom.NewClient(axiom.SetAccessToken("test-token"), axiom.SetURL(srv.URL))
+	require.NoError(t, err)
+
+	var rows []query.Row
+	for row, err := range client.QueryStream(context.Background(), "['test']", axiom.StreamOptions{}) {
+		require.NoError(t, err)
+		rows = append(rows, row)
+	}
+
+	require.Len(t, rows, 4)
+
+	// Values are decoded from the JSON response, so numbers arrive as float64.
+	var counts []float64
+	for _, row := range rows {
+		count, ok := row.Get("count")
+		require.True(t, ok)
+		counts = append(counts, count.(float64))
+	}
+	assert.Equal(t, []float64{1, 2, 3, 4}, counts, "boundary row between batches must not be yielded twice")
+
+	require.Len(t, gotIncludeCursor, 3)
+	assert.True(t, gotIncludeCursor[0], "initial request should include the cursor row")
+	assert.False(t, gotIncludeCursor[1], "resume request must exclude the prior batch's boundary row")
+}
+
+func TestClient_QueryStream_SendsBatchSizeAsLimit(t *testing.T) {
+	var gotLimit int
+
+	r := http.NewServeMux()
+	r.HandleFunc("/v1/datasets/_apl", func(w http.ResponseWriter, req *http.Request) {
+		var body struct {
+			Limit int `json:"limit"`
+		}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		gotLimit = body.Limit
+
+		result := query.Result{
+			Tables: []query.Table{{
+				Fields:  []query.Field{{Name: "_time", Type: "datetime"}},
+				Columns: []query.Column{},
+			}},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(result))
+	})
+
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	client, err := axiom.NewClient(axiom.SetAccessToken("test-token"), axiom.SetURL(srv.URL))
+	require.NoError(t, err)
+
+	for _, err := range client.QueryStream(context.Background(), "['test']", axiom.StreamOptions{BatchSize: 50}) {
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 50, gotLimit)
+}
+
+func TestClient_QueryStream_RefusesAggregatingQueries(t *testing.T) {
+	r := http.NewServeMux()
+	r.HandleFunc("/v1/datasets/_apl", func(w http.ResponseWriter, req *http.Request) {
+		result := query.Result{
+			Tables: []query.Table{{
+				Fields: []query.Field{{Name: "count", Type: "int", Aggregation: query.Aggregation{Name: "count"}}},
+			}},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(result))
+	})
+
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	client, err := axiom.NewClient(axiom.SetAccessToken("test-token"), axiom.SetURL(srv.URL))
+	require.NoError(t, err)
+
+	var gotErr error
+	for _, err := range client.QueryStream(context.Background(), "['test'] | summarize count()", axiom.StreamOptions{}) {
+		gotErr = err
+		break
+	}
+
+	assert.Error(t, gotErr)
+}